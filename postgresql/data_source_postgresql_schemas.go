@@ -1,82 +1,129 @@
 package postgresql
 
 import (
+	"database/sql"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lib/pq"
 )
 
+// Both queries preserve the visibility rules information_schema.schemata
+// applied: only schemas the current role has USAGE/CREATE on (directly or via
+// role membership) are returned, and pg_toast* schemas are always excluded.
 var schemaQueries = map[string]string{
 	"query_include_system_schemas": `
-	SELECT schema_name
-	FROM information_schema.schemata s
+	SELECT n.nspname
+	FROM pg_catalog.pg_namespace n
+	WHERE (pg_catalog.pg_has_role(n.nspowner, 'USAGE') OR pg_catalog.has_schema_privilege(n.oid, 'CREATE, USAGE'))
+	AND n.nspname !~ '^pg_toast'
 	`,
 	"query_exclude_system_schemas": `
-	SELECT schema_name
-	FROM information_schema.schemata s
-	WHERE s.schema_name NOT LIKE 'pg_%'
-	AND s.schema_name <> 'information_schema'
+	SELECT n.nspname
+	FROM pg_catalog.pg_namespace n
+	WHERE (pg_catalog.pg_has_role(n.nspowner, 'USAGE') OR pg_catalog.has_schema_privilege(n.oid, 'CREATE, USAGE'))
+	AND n.nspname !~ '^pg_toast'
+	AND n.nspname NOT LIKE 'pg_%'
+	AND n.nspname <> 'information_schema'
 	`,
 }
 
-const (
-	queryArrayKeywordAny = "ANY"
-	queryArrayKeywordAll = "ALL"
-)
-
 func dataSourcePostgreSQLDatabaseSchemas() *schema.Resource {
-	return &schema.Resource{
-		Read: PGResourceFunc(dataSourcePostgreSQLSchemasRead),
-		Schema: map[string]*schema.Schema{
-			"database": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The PostgreSQL database which will be queried for schema names",
-			},
-			"include_system_schemas": {
-				Type:        schema.TypeBool,
-				Default:     false,
-				Optional:    true,
-				Description: "Determines whether to include system schemas (pg_ prefix and information_schema). 'public' will always be included.",
-			},
-			"like_any_patterns": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				MinItems:    0,
-				Description: "Expression(s) which will be pattern matched in the query using the PostgreSQL LIKE ANY operator",
-			},
-			"like_all_patterns": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				MinItems:    0,
-				Description: "Expression(s) which will be pattern matched in the query using the PostgreSQL LIKE ALL operator",
-			},
-			"not_like_all_patterns": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				MinItems:    0,
-				Description: "Expression(s) which will be pattern matched in the query using the PostgreSQL NOT LIKE ALL operator",
-			},
-			"regex_pattern": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Expression which will be pattern matched in the query using the PostgreSQL ~ (regular expression match) operator",
-			},
-			"schemas": {
-				Type:        schema.TypeSet,
-				Computed:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Set:         schema.HashString,
-				Description: "The list of PostgreSQL schemas retrieved by this data source",
+	s := map[string]*schema.Schema{
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The PostgreSQL database which will be queried for schema names",
+		},
+		"include_system_schemas": {
+			Type:        schema.TypeBool,
+			Default:     false,
+			Optional:    true,
+			Description: "Determines whether to include system schemas (pg_ prefix and information_schema). 'public' will always be included.",
+		},
+		"include_stats": {
+			Type:        schema.TypeBool,
+			Default:     false,
+			Optional:    true,
+			Description: "Determines whether schemas_detail includes size_bytes and table_count, which require scanning pg_class and summing pg_total_relation_size over every table in each schema",
+		},
+		"owner_in": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    0,
+			Description: "Only include schemas owned by one of these roles",
+		},
+		"owner_not_in": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    0,
+			Description: "Exclude schemas owned by any of these roles",
+		},
+		"comment_regex": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Expression which will be pattern matched against the schema's comment using the PostgreSQL ~ (regular expression match) operator",
+		},
+		"schemas": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Description: "The list of PostgreSQL schemas retrieved by this data source",
+		},
+		"schemas_detail": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Metadata for each PostgreSQL schema retrieved by this data source",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The schema name",
+					},
+					"owner": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The role which owns the schema",
+					},
+					"acl": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The schema's access privileges, in the format returned by pg_namespace.nspacl",
+					},
+					"comment": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The schema's comment, as set by COMMENT ON SCHEMA",
+					},
+					"size_bytes": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The combined on-disk size, in bytes, of every ordinary table in the schema. Only populated when include_stats is true",
+					},
+					"table_count": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The number of ordinary tables in the schema. Only populated when include_stats is true",
+					},
+				},
 			},
 		},
 	}
+	for name, field := range patternMatchingSchema() {
+		s[name] = field
+	}
+
+	return &schema.Resource{
+		Read:   PGResourceFunc(dataSourcePostgreSQLSchemasRead),
+		Schema: s,
+	}
 }
 
 func dataSourcePostgreSQLSchemasRead(db *DBConnection, d *schema.ResourceData) error {
@@ -97,9 +144,12 @@ func dataSourcePostgreSQLSchemasRead(db *DBConnection, d *schema.ResourceData) e
 		query = schemaQueries["query_exclude_system_schemas"]
 	}
 
-	query = applyOptionalPatternMatchingToQuery(query, !includeSystemSchemas, d)
+	query, args := applyOptionalPatternMatchingToQuery(query, "n.nspname", true, d)
+
+	query, ownerAndCommentArgs := applyOwnerAndCommentPatternMatchingToQuery(query, true, d, len(args)+1)
+	args = append(args, ownerAndCommentArgs...)
 
-	rows, err := txn.Query(query)
+	rows, err := txn.Query(query, args...)
 	if err != nil {
 		return err
 	}
@@ -116,66 +166,125 @@ func dataSourcePostgreSQLSchemasRead(db *DBConnection, d *schema.ResourceData) e
 	}
 
 	d.Set("schemas", stringSliceToSet(schemas))
+
+	schemasDetail, err := readSchemasDetail(txn, schemas, d.Get("include_stats").(bool))
+	if err != nil {
+		return err
+	}
+	d.Set("schemas_detail", schemasDetail)
+
 	d.SetId(generateDataSourceSchemasID(d, database))
 
 	return nil
 }
 
-func applyOptionalPatternMatchingToQuery(query string, queryContainsWhere bool, d *schema.ResourceData) string {
-	likeAnyPatterns := d.Get("like_any_patterns").([]interface{})
-	likeAllPatterns := d.Get("like_all_patterns").([]interface{})
-	notLikeAllPatterns := d.Get("not_like_all_patterns").([]interface{})
-	regexPattern := d.Get("regex_pattern").(string)
+// applyOwnerAndCommentPatternMatchingToQuery appends the owner_in, owner_not_in
+// and comment_regex filters (if set) to query as parameterized predicates against
+// pg_namespace.nspowner and its comment, with placeholders numbered starting at
+// firstArgIndex so they can share an args slice with a preceding call to
+// applyOptionalPatternMatchingToQuery.
+func applyOwnerAndCommentPatternMatchingToQuery(query string, queryContainsWhere bool, d *schema.ResourceData, firstArgIndex int) (string, []interface{}) {
+	ownerIn := d.Get("owner_in").([]interface{})
+	ownerNotIn := d.Get("owner_not_in").([]interface{})
+	commentRegex := d.Get("comment_regex").(string)
 
-	likePatternQuery := "s.schema_name LIKE"
-	notLikePatternQuery := "s.schema_name NOT LIKE"
-	regexPatternQuery := "s.schema_name ~"
+	ownerColumn := "n.nspowner::regrole::text"
+	commentColumn := "pg_catalog.obj_description(n.oid, 'pg_namespace')"
 
+	args := []interface{}{}
 	filters := []string{}
-	if len(likeAnyPatterns) > 0 {
-		filters = append(filters, concatenateQueryWithPatternMatching(likePatternQuery, generatePatternArrayString(likeAnyPatterns, queryArrayKeywordAny)))
-	}
-	if len(likeAllPatterns) > 0 {
-		filters = append(filters, concatenateQueryWithPatternMatching(likePatternQuery, generatePatternArrayString(likeAllPatterns, queryArrayKeywordAll)))
+	if len(ownerIn) > 0 {
+		arrayString, arrayArgs := generatePatternArrayString(ownerIn, queryArrayKeywordAny, firstArgIndex+len(args))
+		args = append(args, arrayArgs...)
+		filters = append(filters, fmt.Sprintf("%s = %s", ownerColumn, arrayString))
 	}
-	if len(notLikeAllPatterns) > 0 {
-		filters = append(filters, concatenateQueryWithPatternMatching(notLikePatternQuery, generatePatternArrayString(notLikeAllPatterns, queryArrayKeywordAll)))
+	if len(ownerNotIn) > 0 {
+		arrayString, arrayArgs := generatePatternArrayString(ownerNotIn, queryArrayKeywordAll, firstArgIndex+len(args))
+		args = append(args, arrayArgs...)
+		filters = append(filters, fmt.Sprintf("%s <> %s", ownerColumn, arrayString))
 	}
-	if regexPattern != "" {
-		filters = append(filters, concatenateQueryWithPatternMatching(regexPatternQuery, fmt.Sprintf("'%s'", regexPattern)))
+	if commentRegex != "" {
+		args = append(args, commentRegex)
+		filters = append(filters, fmt.Sprintf("%s ~ $%d", commentColumn, firstArgIndex+len(args)-1))
 	}
 
-	if len(filters) > 0 {
-		queryConcatKeyword := "WHERE"
-		if queryContainsWhere {
-			queryConcatKeyword = "AND"
-		}
-		query = fmt.Sprintf("%s %s %s", query, queryConcatKeyword, strings.Join(filters, " AND "))
-	}
-
-	return query
+	return appendFiltersToQuery(query, queryContainsWhere, filters), args
 }
 
-func generatePatternArrayString(patterns []interface{}, queryArrayKeyword string) string {
-	formattedPatterns := []string{}
+// readSchemasDetail populates the schemas_detail computed attribute, resolving
+// each schema's owner and comment via pg_get_userbyid/obj_description against
+// pg_namespace. When includeStats is true, it additionally joins a pg_class
+// aggregate to compute each schema's on-disk table size and table count; this
+// join scans every table in the database via pg_total_relation_size, so it is
+// opt-in.
+func readSchemasDetail(txn *sql.Tx, schemaNames []string, includeStats bool) ([]map[string]interface{}, error) {
+	query := `
+	SELECT
+		n.nspname,
+		pg_catalog.pg_get_userbyid(n.nspowner),
+		COALESCE(n.nspacl::text, ''),
+		COALESCE(pg_catalog.obj_description(n.oid, 'pg_namespace'), '')`
 
-	for _, pattern := range patterns {
-		formattedPatterns = append(formattedPatterns, fmt.Sprintf("'%s'", pattern.(string)))
+	if includeStats {
+		query += `,
+		COALESCE(stats.size_bytes, 0),
+		COALESCE(stats.table_count, 0)
+	FROM pg_catalog.pg_namespace n
+	LEFT JOIN (
+		SELECT c.relnamespace, sum(pg_catalog.pg_total_relation_size(c.oid)) AS size_bytes, count(*) AS table_count
+		FROM pg_catalog.pg_class c
+		WHERE c.relkind = 'r'
+		GROUP BY c.relnamespace
+	) stats ON stats.relnamespace = n.oid`
+	} else {
+		query += `
+	FROM pg_catalog.pg_namespace n`
 	}
-	return fmt.Sprintf("%s (array[%s])", queryArrayKeyword, strings.Join(formattedPatterns, ","))
+	query += `
+	WHERE n.nspname = ANY($1)
+	`
 
-}
+	rows, err := txn.Query(query, pq.Array(schemaNames))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	detail := []map[string]interface{}{}
+	for rows.Next() {
+		row := map[string]interface{}{
+			"size_bytes":  0,
+			"table_count": 0,
+		}
+		var name, owner, acl, comment string
+		scanArgs := []interface{}{&name, &owner, &acl, &comment}
+		var sizeBytes, tableCount int
+		if includeStats {
+			scanArgs = append(scanArgs, &sizeBytes, &tableCount)
+		}
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, fmt.Errorf("could not scan schema detail: %w", err)
+		}
+		row["name"] = name
+		row["owner"] = owner
+		row["acl"] = acl
+		row["comment"] = comment
+		if includeStats {
+			row["size_bytes"] = sizeBytes
+			row["table_count"] = tableCount
+		}
+		detail = append(detail, row)
+	}
 
-func concatenateQueryWithPatternMatching(additionalQuery string, pattern string) string {
-	return fmt.Sprintf("%s %s", additionalQuery, pattern)
+	return detail, nil
 }
 
 func generateDataSourceSchemasID(d *schema.ResourceData, databaseName string) string {
-	return strings.Join([]string{
+	idParts := append([]string{
 		databaseName, strconv.FormatBool(d.Get("include_system_schemas").(bool)),
-		generatePatternArrayString(d.Get("like_any_patterns").([]interface{}), queryArrayKeywordAny),
-		generatePatternArrayString(d.Get("like_all_patterns").([]interface{}), queryArrayKeywordAll),
-		generatePatternArrayString(d.Get("not_like_all_patterns").([]interface{}), queryArrayKeywordAll),
-		d.Get("regex_pattern").(string),
-	}, "_")
+		joinInterfaceSlice(d.Get("owner_in").([]interface{})),
+		joinInterfaceSlice(d.Get("owner_not_in").([]interface{})),
+		d.Get("comment_regex").(string),
+	}, patternMatchingID(d)...)
+	return strings.Join(idParts, "_")
 }
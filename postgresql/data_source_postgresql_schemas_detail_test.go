@@ -0,0 +1,61 @@
+package postgresql
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPostgresqlDatabaseSchemas_SchemasDetail(t *testing.T) {
+	config := getTestConfig(t)
+	dsn := config.connStr("postgres")
+
+	dbExecute(t, dsn, "CREATE SCHEMA schemas_detail_test_schema")
+	defer dbExecute(t, dsn, "DROP SCHEMA schemas_detail_test_schema CASCADE")
+
+	dbExecute(t, dsn, "COMMENT ON SCHEMA schemas_detail_test_schema IS 'a test schema'")
+	dbExecute(t, dsn, "CREATE TABLE schemas_detail_test_schema.foo (id serial primary key)")
+	dbExecute(t, dsn, "CREATE TABLE schemas_detail_test_schema.bar (id serial primary key)")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Without include_stats, owner/comment are populated but
+				// size_bytes/table_count stay at their zero value rather
+				// than triggering the pg_total_relation_size scan.
+				Config: `
+				data "postgresql_schemas" "test" {
+					database          = "postgres"
+					like_any_patterns = ["schemas_detail_test_schema"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas_detail.#", "1"),
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas_detail.0.name", "schemas_detail_test_schema"),
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas_detail.0.comment", "a test schema"),
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas_detail.0.size_bytes", "0"),
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas_detail.0.table_count", "0"),
+				),
+			},
+			{
+				// With include_stats, size_bytes/table_count reflect the two
+				// tables created above.
+				Config: `
+				data "postgresql_schemas" "test" {
+					database          = "postgres"
+					like_any_patterns = ["schemas_detail_test_schema"]
+					include_stats     = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas_detail.#", "1"),
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas_detail.0.table_count", "2"),
+					resource.TestMatchResourceAttr("data.postgresql_schemas.test", "schemas_detail.0.size_bytes", regexp.MustCompile(`^[1-9][0-9]*$`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package postgresql
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestApplyOwnerAndCommentPatternMatchingToQuery(t *testing.T) {
+	s := dataSourcePostgreSQLDatabaseSchemas().Schema
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"like_any_patterns": []interface{}{"tenant_%"},
+		"owner_in":          []interface{}{"alice", "bob"},
+		"comment_regex":     "^tenant",
+	})
+
+	// Mirrors dataSourcePostgreSQLSchemasRead: applyOwnerAndCommentPatternMatchingToQuery
+	// must continue placeholder numbering from where applyOptionalPatternMatchingToQuery
+	// left off, since both sets of args are bound to the same query.
+	query, args := applyOptionalPatternMatchingToQuery("SELECT n.nspname FROM pg_catalog.pg_namespace n", "n.nspname", false, d)
+	if len(args) != 1 {
+		t.Fatalf("expected 1 arg from the name filter, got %d: %#v", len(args), args)
+	}
+
+	query, ownerAndCommentArgs := applyOwnerAndCommentPatternMatchingToQuery(query, true, d, len(args)+1)
+	args = append(args, ownerAndCommentArgs...)
+
+	expectedQuery := "SELECT n.nspname FROM pg_catalog.pg_namespace n WHERE n.nspname LIKE ANY (array[$1]) AND n.nspowner::regrole::text = ANY (array[$2,$3]) AND pg_catalog.obj_description(n.oid, 'pg_namespace') ~ $4"
+	if query != expectedQuery {
+		t.Fatalf("unexpected query:\n got:  %s\n want: %s", query, expectedQuery)
+	}
+
+	expectedArgs := []interface{}{"tenant_%", "alice", "bob", "^tenant"}
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Fatalf("unexpected args: got %#v, want %#v", args, expectedArgs)
+	}
+}
+
+func TestApplyOwnerAndCommentPatternMatchingToQueryOwnerNotIn(t *testing.T) {
+	s := dataSourcePostgreSQLDatabaseSchemas().Schema
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"owner_not_in": []interface{}{"postgres"},
+	})
+
+	query, args := applyOwnerAndCommentPatternMatchingToQuery("SELECT 1", false, d, 1)
+
+	expectedQuery := "SELECT 1 WHERE n.nspowner::regrole::text <> ALL (array[$1])"
+	if query != expectedQuery {
+		t.Fatalf("unexpected query:\n got:  %s\n want: %s", query, expectedQuery)
+	}
+
+	expectedArgs := []interface{}{"postgres"}
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Fatalf("unexpected args: got %#v, want %#v", args, expectedArgs)
+	}
+}
+
+// TestSchemaQueriesPreservePrivilegeAndToastFiltering guards against a regression
+// (see d4fbfce) where switching schemaQueries from information_schema.schemata to
+// pg_namespace silently dropped the privilege check and pg_toast exclusion that
+// the information_schema view applied.
+func TestSchemaQueriesPreservePrivilegeAndToastFiltering(t *testing.T) {
+	for name, query := range schemaQueries {
+		if !strings.Contains(query, "pg_has_role(n.nspowner, 'USAGE')") || !strings.Contains(query, "has_schema_privilege(n.oid, 'CREATE, USAGE')") {
+			t.Errorf("schemaQueries[%q] is missing the USAGE/CREATE privilege check", name)
+		}
+		if !strings.Contains(query, "n.nspname !~ '^pg_toast'") {
+			t.Errorf("schemaQueries[%q] is missing the pg_toast exclusion", name)
+		}
+	}
+}
+
+func TestAccPostgresqlDatabaseSchemas_OwnerAndCommentFilters(t *testing.T) {
+	config := getTestConfig(t)
+	dsn := config.connStr("postgres")
+
+	dbExecute(t, dsn, "CREATE ROLE owner_filter_test_role")
+	defer dbExecute(t, dsn, "DROP ROLE owner_filter_test_role")
+
+	dbExecute(t, dsn, "CREATE SCHEMA owner_filter_test_schema_a AUTHORIZATION owner_filter_test_role")
+	defer dbExecute(t, dsn, "DROP SCHEMA owner_filter_test_schema_a CASCADE")
+	dbExecute(t, dsn, "COMMENT ON SCHEMA owner_filter_test_schema_a IS 'tenant: acme'")
+
+	dbExecute(t, dsn, "CREATE SCHEMA owner_filter_test_schema_b")
+	defer dbExecute(t, dsn, "DROP SCHEMA owner_filter_test_schema_b CASCADE")
+	dbExecute(t, dsn, "COMMENT ON SCHEMA owner_filter_test_schema_b IS 'tenant: globex'")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "postgresql_schemas" "test" {
+					database          = "postgres"
+					like_any_patterns = ["owner_filter_test_schema_%"]
+					owner_in          = ["owner_filter_test_role"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_schemas.test", "schemas.*", "owner_filter_test_schema_a"),
+				),
+			},
+			{
+				Config: `
+				data "postgresql_schemas" "test" {
+					database          = "postgres"
+					like_any_patterns = ["owner_filter_test_schema_%"]
+					owner_not_in      = ["owner_filter_test_role"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_schemas.test", "schemas.*", "owner_filter_test_schema_b"),
+				),
+			},
+			{
+				Config: `
+				data "postgresql_schemas" "test" {
+					database      = "postgres"
+					like_any_patterns = ["owner_filter_test_schema_%"]
+					comment_regex = "globex$"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_schemas.test", "schemas.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_schemas.test", "schemas.*", "owner_filter_test_schema_b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlDatabaseSchemas_ExcludesPgToast(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "postgresql_schemas" "test" {
+					database               = "postgres"
+					include_system_schemas = true
+				}
+				`,
+				Check: checkNoSchemaHasPrefix("data.postgresql_schemas.test", "pg_toast"),
+			},
+		},
+	})
+}
+
+// checkNoSchemaHasPrefix asserts that none of the resource's "schemas" set
+// members start with prefix, since resource.TestCheckTypeSetElemAttr can only
+// assert presence of an exact element, not absence of a pattern.
+func checkNoSchemaHasPrefix(resourceName string, prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, "schemas.") && strings.HasPrefix(value, prefix) {
+				return fmt.Errorf("expected no schema with prefix %q, found %q", prefix, value)
+			}
+		}
+		return nil
+	}
+}
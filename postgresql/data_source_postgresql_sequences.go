@@ -0,0 +1,104 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sequenceRelkind is the pg_class.relkind value for sequences.
+const sequenceRelkind = "S"
+
+func dataSourcePostgreSQLSequences() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The PostgreSQL database which will be queried for sequence names",
+		},
+		"schemas": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    0,
+			Description: "Scope the list of sequences to a list of schemas. If not specified, all schemas in the database are searched",
+		},
+		"sequences": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Description: "The list of PostgreSQL sequences retrieved by this data source, schema-qualified as schema.sequence",
+		},
+	}
+	for name, field := range patternMatchingSchema() {
+		s[name] = field
+	}
+
+	return &schema.Resource{
+		Read:   PGResourceFunc(dataSourcePostgreSQLSequencesRead),
+		Schema: s,
+	}
+}
+
+func dataSourcePostgreSQLSequencesRead(db *DBConnection, d *schema.ResourceData) error {
+	database := d.Get("database").(string)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	query := `
+	SELECT n.nspname, c.relname
+	FROM pg_class c
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+	WHERE c.relkind = $1
+	`
+	args := []interface{}{sequenceRelkind}
+
+	schemas := d.Get("schemas").([]interface{})
+	if len(schemas) > 0 {
+		placeholders := make([]string, len(schemas))
+		for i, s := range schemas {
+			args = append(args, s.(string))
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		query += fmt.Sprintf("AND n.nspname IN (%s)\n", strings.Join(placeholders, ","))
+	}
+
+	query, patternArgs := applyOptionalPatternMatchingToQuery(query, "c.relname", true, d)
+	args = append(args, patternArgs...)
+
+	rows, err := txn.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	sequences := []string{}
+	for rows.Next() {
+		var schemaName, sequenceName string
+
+		if err = rows.Scan(&schemaName, &sequenceName); err != nil {
+			return fmt.Errorf("could not scan schema/sequence name for database: %w", err)
+		}
+		sequences = append(sequences, fmt.Sprintf("%s.%s", schemaName, sequenceName))
+	}
+
+	d.Set("sequences", stringSliceToSet(sequences))
+	d.SetId(generateDataSourceSequencesID(d, database))
+
+	return nil
+}
+
+func generateDataSourceSequencesID(d *schema.ResourceData, databaseName string) string {
+	idParts := append([]string{
+		databaseName,
+		joinInterfaceSlice(d.Get("schemas").([]interface{})),
+	}, patternMatchingID(d)...)
+	return strings.Join(idParts, "_")
+}
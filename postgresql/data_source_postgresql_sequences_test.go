@@ -0,0 +1,51 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPostgresqlSequences_Basic(t *testing.T) {
+	config := getTestConfig(t)
+	dsn := config.connStr("postgres")
+
+	dbExecute(t, dsn, "CREATE SCHEMA sequences_test_schema")
+	defer dbExecute(t, dsn, "DROP SCHEMA sequences_test_schema CASCADE")
+
+	dbExecute(t, dsn, "CREATE SEQUENCE sequences_test_schema.foo_seq")
+	dbExecute(t, dsn, "CREATE SEQUENCE sequences_test_schema.bar_seq")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "postgresql_sequences" "test" {
+					database = "postgres"
+					schemas  = ["sequences_test_schema"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_sequences.test", "sequences.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_sequences.test", "sequences.*", "sequences_test_schema.foo_seq"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_sequences.test", "sequences.*", "sequences_test_schema.bar_seq"),
+				),
+			},
+			{
+				Config: `
+				data "postgresql_sequences" "test" {
+					database          = "postgres"
+					schemas           = ["sequences_test_schema"]
+					like_any_patterns = ["foo%"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_sequences.test", "sequences.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_sequences.test", "sequences.*", "sequences_test_schema.foo_seq"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,138 @@
+package postgresql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lib/pq"
+)
+
+// defaultTableKinds mirrors psql's \d default of listing ordinary tables,
+// partitioned tables, views, materialized views and foreign tables. The
+// physical partitions of a partitioned table are relkind 'r' with
+// relispartition = true, and are suppressed separately via include_partitions
+// rather than by omitting 'p' (which would also hide partitioned tables
+// themselves and any intermediate sub-partitions).
+var defaultTableKinds = []string{"r", "p", "v", "m", "f"}
+
+func dataSourcePostgreSQLTables() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The PostgreSQL database which will be queried for table names",
+		},
+		"schemas": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    0,
+			Description: "Scope the list of tables to a list of schemas. If not specified, all schemas in the database are searched",
+		},
+		"kinds": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    0,
+			Description: "Kinds of relations to return, mapped to pg_class.relkind: \"r\" (ordinary table), \"p\" (partitioned table), \"v\" (view), \"m\" (materialized view), \"f\" (foreign table). Defaults to r, p, v, m and f",
+		},
+		"include_partitions": {
+			Type:        schema.TypeBool,
+			Default:     false,
+			Optional:    true,
+			Description: "Determines whether partitions of partitioned tables are included in the results",
+		},
+		"tables": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Description: "The list of PostgreSQL tables retrieved by this data source, schema-qualified as schema.table",
+		},
+	}
+	for name, field := range patternMatchingSchema() {
+		s[name] = field
+	}
+
+	return &schema.Resource{
+		Read:   PGResourceFunc(dataSourcePostgreSQLTablesRead),
+		Schema: s,
+	}
+}
+
+func dataSourcePostgreSQLTablesRead(db *DBConnection, d *schema.ResourceData) error {
+	database := d.Get("database").(string)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	kinds := d.Get("kinds").([]interface{})
+	if len(kinds) == 0 {
+		kinds = make([]interface{}, len(defaultTableKinds))
+		for i, kind := range defaultTableKinds {
+			kinds[i] = kind
+		}
+	}
+
+	query := `
+	SELECT n.nspname, c.relname
+	FROM pg_class c
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+	WHERE c.relkind = ANY($1)
+	`
+	args := []interface{}{pq.Array(kinds)}
+
+	if !d.Get("include_partitions").(bool) {
+		query += "AND c.relispartition IS NOT TRUE\n"
+	}
+
+	schemas := d.Get("schemas").([]interface{})
+	if len(schemas) > 0 {
+		placeholders := make([]string, len(schemas))
+		for i, s := range schemas {
+			args = append(args, s.(string))
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		query += fmt.Sprintf("AND n.nspname IN (%s)\n", strings.Join(placeholders, ","))
+	}
+
+	query, patternArgs := applyOptionalPatternMatchingToQuery(query, "c.relname", true, d)
+	args = append(args, patternArgs...)
+
+	rows, err := txn.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	tables := []string{}
+	for rows.Next() {
+		var schemaName, tableName string
+
+		if err = rows.Scan(&schemaName, &tableName); err != nil {
+			return fmt.Errorf("could not scan schema/table name for database: %w", err)
+		}
+		tables = append(tables, fmt.Sprintf("%s.%s", schemaName, tableName))
+	}
+
+	d.Set("tables", stringSliceToSet(tables))
+	d.SetId(generateDataSourceTablesID(d, database))
+
+	return nil
+}
+
+func generateDataSourceTablesID(d *schema.ResourceData, databaseName string) string {
+	idParts := append([]string{
+		databaseName,
+		joinInterfaceSlice(d.Get("schemas").([]interface{})),
+		joinInterfaceSlice(d.Get("kinds").([]interface{})),
+		strconv.FormatBool(d.Get("include_partitions").(bool)),
+	}, patternMatchingID(d)...)
+	return strings.Join(idParts, "_")
+}
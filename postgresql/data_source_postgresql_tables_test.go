@@ -0,0 +1,102 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPostgresqlTables_Basic(t *testing.T) {
+	config := getTestConfig(t)
+	dsn := config.connStr("postgres")
+
+	dbExecute(t, dsn, "CREATE SCHEMA tables_test_schema")
+	defer dbExecute(t, dsn, "DROP SCHEMA tables_test_schema CASCADE")
+
+	dbExecute(t, dsn, "CREATE TABLE tables_test_schema.foo (id serial primary key)")
+	dbExecute(t, dsn, "CREATE TABLE tables_test_schema.bar (id serial primary key)")
+	dbExecute(t, dsn, "CREATE VIEW tables_test_schema.foo_view AS SELECT id FROM tables_test_schema.foo")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "postgresql_tables" "test" {
+					database = "postgres"
+					schemas  = ["tables_test_schema"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_tables.test", "tables.#", "3"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_tables.test", "tables.*", "tables_test_schema.foo"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_tables.test", "tables.*", "tables_test_schema.bar"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_tables.test", "tables.*", "tables_test_schema.foo_view"),
+				),
+			},
+			{
+				Config: `
+				data "postgresql_tables" "test" {
+					database          = "postgres"
+					schemas           = ["tables_test_schema"]
+					kinds             = ["r", "v"]
+					like_any_patterns = ["foo%"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_tables.test", "tables.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_tables.test", "tables.*", "tables_test_schema.foo"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_tables.test", "tables.*", "tables_test_schema.foo_view"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlTables_PartitionedDefaultKinds(t *testing.T) {
+	config := getTestConfig(t)
+	dsn := config.connStr("postgres")
+
+	dbExecute(t, dsn, "CREATE SCHEMA tables_test_partitioned_schema")
+	defer dbExecute(t, dsn, "DROP SCHEMA tables_test_partitioned_schema CASCADE")
+
+	dbExecute(t, dsn, "CREATE TABLE tables_test_partitioned_schema.measurements (id serial, logged_at date) PARTITION BY RANGE (logged_at)")
+	dbExecute(t, dsn, "CREATE TABLE tables_test_partitioned_schema.measurements_2024 PARTITION OF tables_test_partitioned_schema.measurements FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// With no kinds/include_partitions set, the partitioned table
+				// itself (relkind 'p') must be returned, and its physical
+				// partition (relkind 'r', relispartition = true) must not be.
+				Config: `
+				data "postgresql_tables" "test" {
+					database = "postgres"
+					schemas  = ["tables_test_partitioned_schema"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_tables.test", "tables.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_tables.test", "tables.*", "tables_test_partitioned_schema.measurements"),
+				),
+			},
+			{
+				Config: `
+				data "postgresql_tables" "test" {
+					database            = "postgres"
+					schemas             = ["tables_test_partitioned_schema"]
+					include_partitions  = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_tables.test", "tables.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_tables.test", "tables.*", "tables_test_partitioned_schema.measurements"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_tables.test", "tables.*", "tables_test_partitioned_schema.measurements_2024"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lib/pq"
+)
+
+// viewRelkinds are the pg_class.relkind values for ordinary views ("v") and
+// materialized views ("m").
+var viewRelkinds = []string{"v", "m"}
+
+func dataSourcePostgreSQLViews() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The PostgreSQL database which will be queried for view names",
+		},
+		"schemas": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    0,
+			Description: "Scope the list of views to a list of schemas. If not specified, all schemas in the database are searched",
+		},
+		"views": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Description: "The list of PostgreSQL views (including materialized views) retrieved by this data source, schema-qualified as schema.view",
+		},
+	}
+	for name, field := range patternMatchingSchema() {
+		s[name] = field
+	}
+
+	return &schema.Resource{
+		Read:   PGResourceFunc(dataSourcePostgreSQLViewsRead),
+		Schema: s,
+	}
+}
+
+func dataSourcePostgreSQLViewsRead(db *DBConnection, d *schema.ResourceData) error {
+	database := d.Get("database").(string)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	query := `
+	SELECT n.nspname, c.relname
+	FROM pg_class c
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+	WHERE c.relkind = ANY($1)
+	`
+	args := []interface{}{pq.Array(viewRelkinds)}
+
+	schemas := d.Get("schemas").([]interface{})
+	if len(schemas) > 0 {
+		placeholders := make([]string, len(schemas))
+		for i, s := range schemas {
+			args = append(args, s.(string))
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		query += fmt.Sprintf("AND n.nspname IN (%s)\n", strings.Join(placeholders, ","))
+	}
+
+	query, patternArgs := applyOptionalPatternMatchingToQuery(query, "c.relname", true, d)
+	args = append(args, patternArgs...)
+
+	rows, err := txn.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	views := []string{}
+	for rows.Next() {
+		var schemaName, viewName string
+
+		if err = rows.Scan(&schemaName, &viewName); err != nil {
+			return fmt.Errorf("could not scan schema/view name for database: %w", err)
+		}
+		views = append(views, fmt.Sprintf("%s.%s", schemaName, viewName))
+	}
+
+	d.Set("views", stringSliceToSet(views))
+	d.SetId(generateDataSourceViewsID(d, database))
+
+	return nil
+}
+
+func generateDataSourceViewsID(d *schema.ResourceData, databaseName string) string {
+	idParts := append([]string{
+		databaseName,
+		joinInterfaceSlice(d.Get("schemas").([]interface{})),
+	}, patternMatchingID(d)...)
+	return strings.Join(idParts, "_")
+}
@@ -0,0 +1,39 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPostgresqlViews_Basic(t *testing.T) {
+	config := getTestConfig(t)
+	dsn := config.connStr("postgres")
+
+	dbExecute(t, dsn, "CREATE SCHEMA views_test_schema")
+	defer dbExecute(t, dsn, "DROP SCHEMA views_test_schema CASCADE")
+
+	dbExecute(t, dsn, "CREATE TABLE views_test_schema.foo (id serial primary key)")
+	dbExecute(t, dsn, "CREATE VIEW views_test_schema.foo_view AS SELECT id FROM views_test_schema.foo")
+	dbExecute(t, dsn, "CREATE MATERIALIZED VIEW views_test_schema.foo_mat_view AS SELECT id FROM views_test_schema.foo")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "postgresql_views" "test" {
+					database = "postgres"
+					schemas  = ["views_test_schema"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_views.test", "views.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_views.test", "views.*", "views_test_schema.foo_view"),
+					resource.TestCheckTypeSetElemAttr("data.postgresql_views.test", "views.*", "views_test_schema.foo_mat_view"),
+				),
+			},
+		},
+	})
+}
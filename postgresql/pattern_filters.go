@@ -0,0 +1,152 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	queryArrayKeywordAny = "ANY"
+	queryArrayKeywordAll = "ALL"
+)
+
+// patternMatchingSchema returns the like_any_patterns / like_all_patterns /
+// not_like_all_patterns / regex_pattern schema fields shared by every "list
+// objects by name pattern" data source (schemas, tables, views, sequences).
+func patternMatchingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"like_any_patterns": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    0,
+			Description: "Expression(s) which will be pattern matched in the query using the PostgreSQL LIKE ANY operator",
+		},
+		"like_all_patterns": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    0,
+			Description: "Expression(s) which will be pattern matched in the query using the PostgreSQL LIKE ALL operator",
+		},
+		"not_like_all_patterns": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			MinItems:    0,
+			Description: "Expression(s) which will be pattern matched in the query using the PostgreSQL NOT LIKE ALL operator",
+		},
+		"regex_pattern": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Expression which will be pattern matched in the query using the PostgreSQL ~ (regular expression match) operator",
+		},
+	}
+}
+
+// applyOptionalPatternMatchingToQuery appends the like_any_patterns, like_all_patterns,
+// not_like_all_patterns and regex_pattern filters (if set) to query as parameterized
+// predicates against nameColumn, returning the rewritten query along with the ordered
+// args slice that must be passed to txn.Query alongside it.
+func applyOptionalPatternMatchingToQuery(query string, nameColumn string, queryContainsWhere bool, d *schema.ResourceData) (string, []interface{}) {
+	likeAnyPatterns := d.Get("like_any_patterns").([]interface{})
+	likeAllPatterns := d.Get("like_all_patterns").([]interface{})
+	notLikeAllPatterns := d.Get("not_like_all_patterns").([]interface{})
+	regexPattern := d.Get("regex_pattern").(string)
+
+	likePatternQuery := fmt.Sprintf("%s LIKE", nameColumn)
+	notLikePatternQuery := fmt.Sprintf("%s NOT LIKE", nameColumn)
+	regexPatternQuery := fmt.Sprintf("%s ~", nameColumn)
+
+	args := []interface{}{}
+	filters := []string{}
+	if len(likeAnyPatterns) > 0 {
+		arrayString, arrayArgs := generatePatternArrayString(likeAnyPatterns, queryArrayKeywordAny, len(args)+1)
+		args = append(args, arrayArgs...)
+		filters = append(filters, concatenateQueryWithPatternMatching(likePatternQuery, arrayString))
+	}
+	if len(likeAllPatterns) > 0 {
+		arrayString, arrayArgs := generatePatternArrayString(likeAllPatterns, queryArrayKeywordAll, len(args)+1)
+		args = append(args, arrayArgs...)
+		filters = append(filters, concatenateQueryWithPatternMatching(likePatternQuery, arrayString))
+	}
+	if len(notLikeAllPatterns) > 0 {
+		arrayString, arrayArgs := generatePatternArrayString(notLikeAllPatterns, queryArrayKeywordAll, len(args)+1)
+		args = append(args, arrayArgs...)
+		filters = append(filters, concatenateQueryWithPatternMatching(notLikePatternQuery, arrayString))
+	}
+	if regexPattern != "" {
+		args = append(args, regexPattern)
+		filters = append(filters, concatenateQueryWithPatternMatching(regexPatternQuery, fmt.Sprintf("$%d", len(args))))
+	}
+
+	return appendFiltersToQuery(query, queryContainsWhere, filters), args
+}
+
+// appendFiltersToQuery joins filters with AND and appends them to query, using
+// WHERE if queryContainsWhere is false or AND if the query already has a WHERE
+// clause (from a base query or a previously applied set of filters).
+func appendFiltersToQuery(query string, queryContainsWhere bool, filters []string) string {
+	if len(filters) == 0 {
+		return query
+	}
+	queryConcatKeyword := "WHERE"
+	if queryContainsWhere {
+		queryConcatKeyword = "AND"
+	}
+	return fmt.Sprintf("%s %s %s", query, queryConcatKeyword, strings.Join(filters, " AND "))
+}
+
+// generatePatternArrayString renders `ANY (array[$N,$N+1,...])` (or `ALL (...)`),
+// starting numbering at firstArgIndex, and returns the args to bind to those
+// placeholders in order.
+func generatePatternArrayString(patterns []interface{}, queryArrayKeyword string, firstArgIndex int) (string, []interface{}) {
+	placeholders := []string{}
+	args := make([]interface{}, 0, len(patterns))
+
+	for i, pattern := range patterns {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", firstArgIndex+i))
+		args = append(args, pattern.(string))
+	}
+	return fmt.Sprintf("%s (array[%s])", queryArrayKeyword, strings.Join(placeholders, ",")), args
+}
+
+func concatenateQueryWithPatternMatching(additionalQuery string, pattern string) string {
+	return fmt.Sprintf("%s %s", additionalQuery, pattern)
+}
+
+// patternsForID renders patterns as a stable, human-readable string for inclusion
+// in a data source's computed ID. It is not used to build SQL and need not be
+// escaped for that purpose.
+func patternsForID(patterns []interface{}, queryArrayKeyword string) string {
+	formatted := []string{}
+	for _, pattern := range patterns {
+		formatted = append(formatted, pattern.(string))
+	}
+	return fmt.Sprintf("%s (array[%s])", queryArrayKeyword, strings.Join(formatted, ","))
+}
+
+// patternMatchingID renders the like_any_patterns / like_all_patterns /
+// not_like_all_patterns / regex_pattern filters as the trailing segments of a
+// data source's computed ID, shared across the "list objects by pattern" data sources.
+func patternMatchingID(d *schema.ResourceData) []string {
+	return []string{
+		patternsForID(d.Get("like_any_patterns").([]interface{}), queryArrayKeywordAny),
+		patternsForID(d.Get("like_all_patterns").([]interface{}), queryArrayKeywordAll),
+		patternsForID(d.Get("not_like_all_patterns").([]interface{}), queryArrayKeywordAll),
+		d.Get("regex_pattern").(string),
+	}
+}
+
+// joinInterfaceSlice renders a []interface{} of strings (as returned by
+// schema.ResourceData.Get for a TypeList of TypeString) as a comma-joined
+// string, for inclusion in a data source's computed ID.
+func joinInterfaceSlice(values []interface{}) string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = v.(string)
+	}
+	return strings.Join(strs, ",")
+}
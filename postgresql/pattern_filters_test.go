@@ -0,0 +1,91 @@
+package postgresql
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGeneratePatternArrayString(t *testing.T) {
+	arrayString, args := generatePatternArrayString([]interface{}{"foo%", "bar%"}, queryArrayKeywordAny, 1)
+
+	expectedArrayString := "ANY (array[$1,$2])"
+	if arrayString != expectedArrayString {
+		t.Fatalf("unexpected array string: got %q, want %q", arrayString, expectedArrayString)
+	}
+
+	expectedArgs := []interface{}{"foo%", "bar%"}
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Fatalf("unexpected args: got %#v, want %#v", args, expectedArgs)
+	}
+}
+
+func TestGeneratePatternArrayStringOffsetFirstArgIndex(t *testing.T) {
+	// dataSourcePostgreSQLSchemasRead chains applyOptionalPatternMatchingToQuery
+	// with applyOwnerAndCommentPatternMatchingToQuery against a single args
+	// slice, so later callers must be able to continue numbering placeholders
+	// from wherever the previous caller left off.
+	arrayString, args := generatePatternArrayString([]interface{}{"alice", "bob"}, queryArrayKeywordAll, 4)
+
+	expectedArrayString := "ALL (array[$4,$5])"
+	if arrayString != expectedArrayString {
+		t.Fatalf("unexpected array string: got %q, want %q", arrayString, expectedArrayString)
+	}
+
+	expectedArgs := []interface{}{"alice", "bob"}
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Fatalf("unexpected args: got %#v, want %#v", args, expectedArgs)
+	}
+}
+
+func TestApplyOptionalPatternMatchingToQuery(t *testing.T) {
+	s := patternMatchingSchema()
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"like_any_patterns": []interface{}{"foo%", "bar%"},
+		"regex_pattern":     "^baz",
+	})
+
+	query, args := applyOptionalPatternMatchingToQuery("SELECT 1", "n.nspname", false, d)
+
+	expectedQuery := "SELECT 1 WHERE n.nspname LIKE ANY (array[$1,$2]) AND n.nspname ~ $3"
+	if query != expectedQuery {
+		t.Fatalf("unexpected query:\n got:  %s\n want: %s", query, expectedQuery)
+	}
+
+	expectedArgs := []interface{}{"foo%", "bar%", "^baz"}
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Fatalf("unexpected args: got %#v, want %#v", args, expectedArgs)
+	}
+}
+
+func TestApplyOptionalPatternMatchingToQueryNoFilters(t *testing.T) {
+	s := patternMatchingSchema()
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+
+	query, args := applyOptionalPatternMatchingToQuery("SELECT 1", "n.nspname", false, d)
+
+	if query != "SELECT 1" {
+		t.Fatalf("expected query to be unchanged when no filters are set, got %q", query)
+	}
+	if len(args) != 0 {
+		t.Fatalf("expected no args when no filters are set, got %#v", args)
+	}
+}
+
+func TestAppendFiltersToQuery(t *testing.T) {
+	withWhere := appendFiltersToQuery("SELECT 1 WHERE a = 1", true, []string{"b = 2"})
+	if withWhere != "SELECT 1 WHERE a = 1 AND b = 2" {
+		t.Fatalf("unexpected query: %q", withWhere)
+	}
+
+	withoutWhere := appendFiltersToQuery("SELECT 1", false, []string{"b = 2"})
+	if withoutWhere != "SELECT 1 WHERE b = 2" {
+		t.Fatalf("unexpected query: %q", withoutWhere)
+	}
+
+	unchanged := appendFiltersToQuery("SELECT 1", false, nil)
+	if unchanged != "SELECT 1" {
+		t.Fatalf("expected query to be unchanged with no filters, got %q", unchanged)
+	}
+}